@@ -0,0 +1,59 @@
+package nnz
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Bytes is a wrapper around []byte where a nil or empty slice serializes to
+// SQL/JSON null. JSON encoding uses the base64 representation
+// encoding/json already applies to []byte fields.
+type Bytes []byte
+
+// Scan implements the database/sql/driver.Scanner interface.
+func (b *Bytes) Scan(v interface{}) error {
+	if v == nil {
+		*b = nil
+		return nil
+	}
+	switch v := v.(type) {
+	case []byte:
+		*b = append(Bytes(nil), v...)
+	case string:
+		*b = Bytes(v)
+	default:
+		return &TypeError{Target: fmt.Sprintf("%T", b), Got: fmt.Sprintf("%T", v), Source: "sql"}
+	}
+	return nil
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+func (b Bytes) Value() (driver.Value, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	return []byte(b), nil
+}
+
+// MarshalJSON implements the encoding/json.Marshaler interface.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if len(b) == 0 {
+		return json.Marshal(nil)
+	}
+	return json.Marshal([]byte(b))
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*b = nil
+		return nil
+	}
+	var v []byte
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("nnz: unmarshaling %T: %w", b, err)
+	}
+	*b = v
+	return nil
+}
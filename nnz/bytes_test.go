@@ -0,0 +1,93 @@
+package nnz
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestBytesNilAndEmptyAreNull(t *testing.T) {
+	var b Bytes
+
+	data, err := b.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON(nil) = %s, want null", data)
+	}
+
+	v, err := b.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value(nil) = %v, want nil", v)
+	}
+
+	empty := Bytes{}
+	v, err = empty.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value(empty) = %v, want nil", v)
+	}
+}
+
+func TestBytesScanBytesAndString(t *testing.T) {
+	var b Bytes
+	if err := b.Scan([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "hello" {
+		t.Errorf("Scan([]byte) = %q, want hello", b)
+	}
+
+	var b2 Bytes
+	if err := b2.Scan("hello"); err != nil {
+		t.Fatal(err)
+	}
+	if string(b2) != "hello" {
+		t.Errorf("Scan(string) = %q, want hello", b2)
+	}
+}
+
+func TestBytesScanNil(t *testing.T) {
+	b := Bytes("hello")
+	if err := b.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if b != nil {
+		t.Errorf("Scan(nil) = %v, want nil", b)
+	}
+}
+
+func TestBytesScanRejectsWrongShape(t *testing.T) {
+	var b Bytes
+	err := b.Scan(42)
+	if err == nil {
+		t.Fatal("expected error scanning int into Bytes")
+	}
+	var te *TypeError
+	if !errors.As(err, &te) {
+		t.Fatalf("expected *TypeError, got %T: %v", err, err)
+	}
+}
+
+func TestBytesJSONRoundTrip(t *testing.T) {
+	want := Bytes("hello")
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Bytes
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("round trip = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,45 @@
+package nnz
+
+import "fmt"
+
+// Strict disables the permissive bool/string coercions that scanValue and
+// unmarshalJSONValue apply by default: Bool and Nullable[bool]/Null[bool]
+// accepting 0/1 or "true"/"false", and String and
+// Nullable[string]/Null[string] accepting numbers and booleans. It is false
+// by default to preserve existing behavior; set it to true to make those
+// types' UnmarshalJSON and Scan reject any value that doesn't already match
+// the target shape.
+//
+// Int, Int64, and Float64 already reject fractional/non-numeric input
+// unconditionally (see decodeJSONInt), and UUID, Bytes, and UnixMilli never
+// coerced between shapes in the first place, so Strict has no effect on
+// them.
+var Strict bool
+
+// TypeError lets callers use errors.As to build structured validation
+// errors, the way database/sql and modern JSON decoders do. Every Scan in
+// this package returns it for a source value of a Go type it does not
+// accept, and the UnmarshalJSON of Bool, String, Time, and bool- or
+// string-kinded Nullable[T]/Null[T] returns it for a JSON value of a shape
+// it does not accept. The other UnmarshalJSON methods, and Scan failures
+// while parsing accepted UUID or UnixMilli input, return wrapped errors
+// from encoding/json, strconv, or uuid, which are not *TypeError.
+type TypeError struct {
+	Target string // Go type being decoded into, e.g. "*nnz.Int"
+	Got    string // Go type (or JSON shape) of the offending value
+	Source string // "json" or "sql"
+}
+
+func (e *TypeError) Error() string {
+	return fmt.Sprintf("nnz: %s: unmarshaling %s, got %s", e.Source, e.Target, e.Got)
+}
+
+// retarget overrides the Target field of err if it is a *TypeError,
+// so shared conversion helpers (which only see the underlying primitive
+// type) can report the caller's actual wrapper type.
+func retarget(err error, target string) error {
+	if te, ok := err.(*TypeError); ok {
+		te.Target = target
+	}
+	return err
+}
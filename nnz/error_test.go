@@ -0,0 +1,124 @@
+package nnz
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTypeErrorMessage(t *testing.T) {
+	err := &TypeError{Target: "*nnz.Int", Got: "bool", Source: "json"}
+	want := "nnz: json: unmarshaling *nnz.Int, got bool"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestTypeErrorAs(t *testing.T) {
+	var b Bool
+	err := b.UnmarshalJSON([]byte(`{}`))
+
+	var te *TypeError
+	if !errors.As(err, &te) {
+		t.Fatalf("expected *TypeError, got %T: %v", err, err)
+	}
+	if te.Source != "json" {
+		t.Errorf("Source = %q, want json", te.Source)
+	}
+}
+
+func withStrict(t *testing.T, strict bool, fn func()) {
+	t.Helper()
+	old := Strict
+	Strict = strict
+	defer func() { Strict = old }()
+	fn()
+}
+
+func TestStrictModeBoolScanFromInt64(t *testing.T) {
+	withStrict(t, false, func() {
+		var b Bool
+		if err := b.Scan(int64(1)); err != nil {
+			t.Fatalf("Strict=false: Scan(int64(1)) = %v, want nil error", err)
+		}
+		if b != true {
+			t.Errorf("Strict=false: Scan(int64(1)) = %v, want true", b)
+		}
+	})
+	withStrict(t, true, func() {
+		var b Bool
+		if err := b.Scan(int64(1)); err == nil {
+			t.Fatal("Strict=true: expected error scanning int64 into Bool")
+		}
+	})
+}
+
+func TestStrictModeBoolUnmarshalJSONFromString(t *testing.T) {
+	withStrict(t, false, func() {
+		var b Bool
+		if err := b.UnmarshalJSON([]byte(`"true"`)); err != nil {
+			t.Fatalf("Strict=false: UnmarshalJSON(\"true\") = %v, want nil error", err)
+		}
+		if b != true {
+			t.Errorf("Strict=false: UnmarshalJSON(\"true\") = %v, want true", b)
+		}
+	})
+	withStrict(t, true, func() {
+		var b Bool
+		if err := b.UnmarshalJSON([]byte(`"true"`)); err == nil {
+			t.Fatal("Strict=true: expected error unmarshaling \"true\" into Bool")
+		}
+	})
+}
+
+func TestStrictModeStringScanFromInt64(t *testing.T) {
+	withStrict(t, false, func() {
+		var s String
+		if err := s.Scan(int64(42)); err != nil {
+			t.Fatalf("Strict=false: Scan(int64(42)) = %v, want nil error", err)
+		}
+		if s != "42" {
+			t.Errorf("Strict=false: Scan(int64(42)) = %q, want 42", s)
+		}
+	})
+	withStrict(t, true, func() {
+		var s String
+		if err := s.Scan(int64(42)); err == nil {
+			t.Fatal("Strict=true: expected error scanning int64 into String")
+		}
+	})
+}
+
+func TestStrictModeStringUnmarshalJSONFromBool(t *testing.T) {
+	withStrict(t, false, func() {
+		var s String
+		if err := s.UnmarshalJSON([]byte("true")); err != nil {
+			t.Fatalf("Strict=false: UnmarshalJSON(true) = %v, want nil error", err)
+		}
+		if s != "true" {
+			t.Errorf("Strict=false: UnmarshalJSON(true) = %q, want true", s)
+		}
+	})
+	withStrict(t, true, func() {
+		var s String
+		if err := s.UnmarshalJSON([]byte("true")); err == nil {
+			t.Fatal("Strict=true: expected error unmarshaling true into String")
+		}
+	})
+}
+
+// TestStrictModeHasNoEffectOnInt pins the scope documented on Strict: Int's
+// rejection of fractional input is unconditional, not gated by Strict.
+func TestStrictModeHasNoEffectOnInt(t *testing.T) {
+	withStrict(t, false, func() {
+		var i Int
+		if err := i.UnmarshalJSON([]byte("1.5")); err == nil {
+			t.Fatal("Strict=false: expected error unmarshaling 1.5 into Int")
+		}
+	})
+	withStrict(t, true, func() {
+		var i Int
+		if err := i.UnmarshalJSON([]byte("1.5")); err == nil {
+			t.Fatal("Strict=true: expected error unmarshaling 1.5 into Int")
+		}
+	})
+}
@@ -1,5 +1,9 @@
 // Package nnz defines variants of primitive types where the zero value
 // represents null when (de)serializing with encoding/json and database/sql.
+//
+// Bool, Int, Int64, Float64, String, and Time are hand-written for the
+// common cases; Nullable[T] generalizes the same zero-is-null behavior to
+// any comparable type, e.g. nnz.Nullable[uint32] or nnz.Nullable[MyEnum].
 package nnz
 
 import (
@@ -9,49 +13,40 @@ import (
 	"time"
 )
 
+// Bool is a wrapper around bool where Go false serializes to SQL/JSON null,
+// and SQL/JSON null deserializes to Go false.
 type Bool bool
 
+// Scan implements the database/sql/driver.Scanner interface. Unless Strict
+// is set, it also accepts the integers 0/1 and the strings "true"/"false".
 func (b *Bool) Scan(v interface{}) error {
-	if v == nil {
-		*b = false
-		return nil
-	}
-	switch v := v.(type) {
-	case bool:
-		*b = Bool(v)
-	default:
-		return fmt.Errorf("nnz: scanning %T, got %T", b, v)
+	x := bool(*b)
+	if err := scanValue(&x, v); err != nil {
+		return retarget(err, fmt.Sprintf("%T", b))
 	}
+	*b = Bool(x)
 	return nil
 }
 
+// Value implements the database/sql/driver.Valuer interface.
 func (b Bool) Value() (driver.Value, error) {
-	if b == false {
-		return nil, nil
-	}
-	return bool(b), nil
+	return valueOf(bool(b))
 }
 
+// MarshalJSON implements the encoding/json.Marshaler interface.
 func (b Bool) MarshalJSON() ([]byte, error) {
-	if b == false {
-		return json.Marshal(nil)
-	}
-	return json.Marshal(bool(b))
+	return marshalJSONValue(bool(b))
 }
 
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface. Unless
+// Strict is set, it also accepts the JSON numbers 0/1 and the strings
+// "true"/"false".
 func (b *Bool) UnmarshalJSON(data []byte) error {
-	var v interface{}
-	err := json.Unmarshal(data, &v)
-	if err != nil {
-		return err
-	}
-	if v == nil {
-		*b = false
-	} else if v, ok := v.(bool); ok {
-		*b = Bool(v)
-	} else {
-		return fmt.Errorf("nnz: unmarshaling %T, got %T", b, v)
+	x := bool(*b)
+	if err := unmarshalJSONValue(&x, data); err != nil {
+		return retarget(err, fmt.Sprintf("%T", b))
 	}
+	*b = Bool(x)
 	return nil
 }
 
@@ -61,49 +56,38 @@ type Int int
 
 // Scan implements the database/sql/driver.Scanner interface.
 func (i *Int) Scan(v interface{}) error {
-	if v == nil {
-		*i = 0
-		return nil
-	}
-	switch v := v.(type) {
-	case int64:
-		*i = Int(v)
-	default:
-		return fmt.Errorf("nnz: scanning %T, got %T", i, v)
+	x := int(*i)
+	if err := scanValue(&x, v); err != nil {
+		return retarget(err, fmt.Sprintf("%T", i))
 	}
+	*i = Int(x)
 	return nil
 }
 
 // Value implements the database/sql/driver.Valuer interface.
 func (i Int) Value() (driver.Value, error) {
-	if i == 0 {
-		return nil, nil
-	}
-	return int64(i), nil
+	return valueOf(int(i))
 }
 
 // MarshalJSON implements the encoding/json.Marshaler interface.
 func (i Int) MarshalJSON() ([]byte, error) {
-	if i == 0 {
-		return json.Marshal(nil)
-	}
-	return json.Marshal(int(i))
+	return marshalJSONValue(int(i))
 }
 
-// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface. It
+// accepts a JSON number or a JSON string containing an integer, using
+// json.Number so values beyond 2^53 don't lose precision, and rejects
+// fractional input instead of truncating it.
 func (i *Int) UnmarshalJSON(data []byte) error {
-	var v interface{}
-	err := json.Unmarshal(data, &v)
+	n, ok, err := decodeJSONInt(data)
 	if err != nil {
-		return err
+		return fmt.Errorf("nnz: unmarshaling %T: %w", i, err)
 	}
-	if v == nil {
+	if !ok {
 		*i = 0
-	} else if v, ok := v.(float64); ok {
-		*i = Int(v)
-	} else {
-		return fmt.Errorf("nnz: unmarshaling %T, got %T", i, v)
+		return nil
 	}
+	*i = Int(n)
 	return nil
 }
 
@@ -113,49 +97,39 @@ type Int64 int64
 
 // Scan implements the database/sql/driver.Scanner interface.
 func (i *Int64) Scan(v interface{}) error {
-	if v == nil {
-		*i = 0
-		return nil
-	}
-	switch v := v.(type) {
-	case int64:
-		*i = Int64(v)
-	default:
-		return fmt.Errorf("nnz: scanning %T, got %T", i, v)
+	x := int64(*i)
+	if err := scanValue(&x, v); err != nil {
+		return retarget(err, fmt.Sprintf("%T", i))
 	}
+	*i = Int64(x)
 	return nil
 }
 
 // Value implements the database/sql/driver.Valuer interface.
 func (i Int64) Value() (driver.Value, error) {
-	if i == 0 {
-		return nil, nil
-	}
-	return int64(i), nil
+	return valueOf(int64(i))
 }
 
 // MarshalJSON implements the encoding/json.Marshaler interface.
 func (i Int64) MarshalJSON() ([]byte, error) {
-	if i == 0 {
-		return json.Marshal(nil)
-	}
-	return json.Marshal(int64(i))
+	return marshalJSONValue(int64(i))
 }
 
-// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface. It
+// accepts a JSON number or a JSON string containing an integer, using
+// json.Number so values beyond 2^53 (Snowflake IDs, database primary keys,
+// Unix-nanos) don't lose precision, and rejects fractional input instead of
+// truncating it.
 func (i *Int64) UnmarshalJSON(data []byte) error {
-	var v interface{}
-	err := json.Unmarshal(data, &v)
+	n, ok, err := decodeJSONInt(data)
 	if err != nil {
-		return err
+		return fmt.Errorf("nnz: unmarshaling %T: %w", i, err)
 	}
-	if v == nil {
+	if !ok {
 		*i = 0
-	} else if v, ok := v.(float64); ok {
-		*i = Int64(v)
-	} else {
-		return fmt.Errorf("nnz: unmarshaling %T, got %T", i, v)
+		return nil
 	}
+	*i = Int64(n)
 	return nil
 }
 
@@ -165,49 +139,36 @@ type Float64 float64
 
 // Scan implements the database/sql/driver.Scanner interface.
 func (f *Float64) Scan(v interface{}) error {
-	if v == nil {
-		*f = 0
-		return nil
-	}
-	switch v := v.(type) {
-	case float64:
-		*f = Float64(v)
-	default:
-		return fmt.Errorf("nnz: scanning %T, got %T", f, v)
+	x := float64(*f)
+	if err := scanValue(&x, v); err != nil {
+		return retarget(err, fmt.Sprintf("%T", f))
 	}
+	*f = Float64(x)
 	return nil
 }
 
 // Value implements the database/sql/driver.Valuer interface.
 func (f Float64) Value() (driver.Value, error) {
-	if f == 0 {
-		return nil, nil
-	}
-	return float64(f), nil
+	return valueOf(float64(f))
 }
 
 // MarshalJSON implements the encoding/json.Marshaler interface.
 func (f Float64) MarshalJSON() ([]byte, error) {
-	if f == 0 {
-		return json.Marshal(nil)
-	}
-	return json.Marshal(float64(f))
+	return marshalJSONValue(float64(f))
 }
 
-// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface. It
+// accepts either a JSON number or a JSON string containing one.
 func (f *Float64) UnmarshalJSON(data []byte) error {
-	var v interface{}
-	err := json.Unmarshal(data, &v)
+	n, ok, err := decodeJSONFloat(data)
 	if err != nil {
-		return err
+		return fmt.Errorf("nnz: unmarshaling %T: %w", f, err)
 	}
-	if v == nil {
+	if !ok {
 		*f = 0
-	} else if v, ok := v.(float64); ok {
-		*f = Float64(v)
-	} else {
-		return fmt.Errorf("nnz: unmarshaling %T, got %T", f, v)
+		return nil
 	}
+	*f = Float64(n)
 	return nil
 }
 
@@ -215,53 +176,36 @@ func (f *Float64) UnmarshalJSON(data []byte) error {
 // and SQL/JSON null deserializes to Go "".
 type String string
 
-// Scan implements the database/sql/driver.Scanner interface.
+// Scan implements the database/sql/driver.Scanner interface. Unless Strict
+// is set, it also accepts numbers and booleans, stringifying them.
 func (s *String) Scan(v interface{}) error {
-	if v == nil {
-		*s = ""
-		return nil
-	}
-	switch v := v.(type) {
-	case []byte:
-		*s = String(v)
-	case string:
-		*s = String(v)
-	default:
-		return fmt.Errorf("nnz: scanning %T, got %T", s, v)
+	x := string(*s)
+	if err := scanValue(&x, v); err != nil {
+		return retarget(err, fmt.Sprintf("%T", s))
 	}
+	*s = String(x)
 	return nil
 }
 
 // Value implements the database/sql/driver.Valuer interface.
 func (s String) Value() (driver.Value, error) {
-	if s == "" {
-		return nil, nil
-	}
-	return string(s), nil
+	return valueOf(string(s))
 }
 
 // MarshalJSON implements the encoding/json.Marshaler interface.
 func (s String) MarshalJSON() ([]byte, error) {
-	if s == "" {
-		return json.Marshal(nil)
-	}
-	return json.Marshal(string(s))
+	return marshalJSONValue(string(s))
 }
 
-// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface. Unless
+// Strict is set, it also accepts JSON numbers and booleans, stringifying
+// them.
 func (s *String) UnmarshalJSON(data []byte) error {
-	var v interface{}
-	err := json.Unmarshal(data, &v)
-	if err != nil {
-		return err
-	}
-	if v == nil {
-		*s = ""
-	} else if v, ok := v.(string); ok {
-		*s = String(v)
-	} else {
-		return fmt.Errorf("nnz: unmarshaling %T, got %T", s, v)
+	x := string(*s)
+	if err := unmarshalJSONValue(&x, data); err != nil {
+		return retarget(err, fmt.Sprintf("%T", s))
 	}
+	*s = String(x)
 	return nil
 }
 
@@ -281,7 +225,7 @@ func (t *Time) Scan(v interface{}) error {
 	case time.Time:
 		*t = Time(v)
 	default:
-		return fmt.Errorf("nnz: scanning %T, got %T", t, v)
+		return &TypeError{Target: fmt.Sprintf("%T", t), Got: fmt.Sprintf("%T", v), Source: "sql"}
 	}
 	return nil
 }
@@ -319,7 +263,7 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	} else if v, ok := v.(time.Time); ok {
 		*t = Time(v)
 	} else {
-		return fmt.Errorf("nnz: unmarshaling %T, got %T", t, v)
+		return &TypeError{Target: fmt.Sprintf("%T", t), Got: fmt.Sprintf("%T", v), Source: "json"}
 	}
 	return nil
 }
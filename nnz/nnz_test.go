@@ -0,0 +1,220 @@
+package nnz
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBoolZeroIsNull(t *testing.T) {
+	var b Bool
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON(false) = %s, want null", data)
+	}
+
+	v, err := b.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value(false) = %v, want nil", v)
+	}
+
+	if err := b.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBoolScanAndValue(t *testing.T) {
+	var b Bool
+	if err := b.Scan(true); err != nil {
+		t.Fatal(err)
+	}
+	if b != true {
+		t.Errorf("Scan(true) = %v, want true", b)
+	}
+
+	v, err := b.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("Value() = %v, want true", v)
+	}
+}
+
+func TestBoolUnmarshalJSONRejectsWrongShape(t *testing.T) {
+	var b Bool
+	err := b.UnmarshalJSON([]byte(`{}`))
+	if err == nil {
+		t.Fatal("expected error unmarshaling an object into Bool")
+	}
+	var te *TypeError
+	if !errors.As(err, &te) {
+		t.Fatalf("expected *TypeError, got %T: %v", err, err)
+	}
+}
+
+func TestIntZeroIsNull(t *testing.T) {
+	var i Int
+
+	data, err := json.Marshal(i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON(0) = %s, want null", data)
+	}
+
+	if err := i.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIntScan(t *testing.T) {
+	var i Int
+	if err := i.Scan(int64(42)); err != nil {
+		t.Fatal(err)
+	}
+	if i != 42 {
+		t.Errorf("Scan(int64(42)) = %d, want 42", i)
+	}
+}
+
+func TestFloat64ZeroIsNull(t *testing.T) {
+	var f Float64
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON(0) = %s, want null", data)
+	}
+}
+
+func TestStringZeroIsNull(t *testing.T) {
+	var s String
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON(\"\") = %s, want null", data)
+	}
+
+	if err := s.Scan([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if s != "hello" {
+		t.Errorf("Scan([]byte) = %q, want hello", s)
+	}
+}
+
+func TestTimeZeroIsNull(t *testing.T) {
+	var tm Time
+
+	data, err := json.Marshal(tm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON(zero) = %s, want null", data)
+	}
+
+	v, err := tm.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value(zero) = %v, want nil", v)
+	}
+}
+
+func TestTimeGobRoundTrip(t *testing.T) {
+	want := Time(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	data, err := want.GobEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Time
+	if err := got.GobDecode(data); err != nil {
+		t.Fatal(err)
+	}
+	if !time.Time(got).Equal(time.Time(want)) {
+		t.Errorf("GobDecode(GobEncode(%v)) = %v", want, got)
+	}
+}
+
+func TestNullableIntRoundTrip(t *testing.T) {
+	var n Nullable[int]
+	if err := n.Scan(int64(7)); err != nil {
+		t.Fatal(err)
+	}
+	if n.Val != 7 {
+		t.Errorf("Scan(int64(7)) = %d, want 7", n.Val)
+	}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != int64(7) {
+		t.Errorf("Value() = %v, want int64(7)", v)
+	}
+}
+
+func TestNullableUint32JSONRoundTrip(t *testing.T) {
+	n := Nullable[uint32]{Val: 123}
+
+	data, err := n.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Nullable[uint32]
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if got.Val != 123 {
+		t.Errorf("round trip = %d, want 123", got.Val)
+	}
+}
+
+func TestNullableGobRoundTrip(t *testing.T) {
+	want := Nullable[string]{Val: "hello"}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatal(err)
+	}
+
+	var got Nullable[string]
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Val != want.Val {
+		t.Errorf("round trip = %q, want %q", got.Val, want.Val)
+	}
+}
+
+var (
+	_ sql.Scanner   = (*Nullable[int])(nil)
+	_ driver.Valuer = Nullable[int]{}
+)
@@ -0,0 +1,119 @@
+package nnz
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// Null is the explicit-null counterpart to Nullable[T], modeled on
+// database/sql's NullXxx types. Where Nullable[T] and the legacy
+// Bool/Int/Int64/Float64/String/Time types conflate the zero value with
+// null, Null[T] carries a separate Valid flag so a real 0, "", false, or
+// epoch time round-trips correctly through SQL and JSON. It marshals to
+// JSON null when !Valid, and to the encoded Val otherwise.
+//
+// Scan/Value reuse the same reflect-based conversions as Nullable[T]
+// (scanValue/driverValue), so a NullInt can Scan the int64 a real integer
+// column produces and a NullString can Scan the []byte a TEXT column
+// produces, rather than requiring an exact Go-type match against the
+// driver's representation.
+type Null[T comparable] struct {
+	Val   T
+	Valid bool
+}
+
+// Scan implements the database/sql/driver.Scanner interface.
+func (n *Null[T]) Scan(v interface{}) error {
+	if err := scanValue(&n.Val, v); err != nil {
+		return err
+	}
+	n.Valid = v != nil
+	return nil
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return driverValue(n.Val), nil
+}
+
+// MarshalJSON implements the encoding/json.Marshaler interface.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(n.Val)
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		var zero T
+		n.Val, n.Valid = zero, false
+		return nil
+	}
+	if err := unmarshalJSONValue(&n.Val, data); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// NullBool, NullInt, NullInt64, NullFloat64, NullString, and NullTime are
+// the explicit-null equivalents of Bool, Int, Int64, Float64, String, and
+// Time, for schemas where the zero value is a legitimate, non-null value.
+type (
+	NullBool    = Null[bool]
+	NullInt     = Null[int]
+	NullInt64   = Null[int64]
+	NullFloat64 = Null[float64]
+	NullString  = Null[string]
+	NullTime    = Null[time.Time]
+)
+
+// toNull converts a zero-is-null value to its explicit-null form, treating
+// the zero value as unset just like Value/MarshalJSON already do.
+func toNull[T comparable](v T) Null[T] {
+	var zero T
+	return Null[T]{Val: v, Valid: v != zero}
+}
+
+// fromNull converts an explicit-null value back to its zero-is-null form,
+// collapsing !Valid to the zero value.
+func fromNull[T comparable](n Null[T]) T {
+	if !n.Valid {
+		var zero T
+		return zero
+	}
+	return n.Val
+}
+
+// ToNull and the FromNull functions below convert between the legacy
+// zero-is-null types and their Null[T] explicit-null counterparts, so
+// mixed schemas can interoperate.
+
+func (b Bool) ToNull() NullBool    { return toNull(bool(b)) }
+func BoolFromNull(n NullBool) Bool { return Bool(fromNull(n)) }
+
+func (i Int) ToNull() NullInt   { return toNull(int(i)) }
+func IntFromNull(n NullInt) Int { return Int(fromNull(n)) }
+
+func (i Int64) ToNull() NullInt64     { return toNull(int64(i)) }
+func Int64FromNull(n NullInt64) Int64 { return Int64(fromNull(n)) }
+
+func (f Float64) ToNull() NullFloat64       { return toNull(float64(f)) }
+func Float64FromNull(n NullFloat64) Float64 { return Float64(fromNull(n)) }
+
+func (s String) ToNull() NullString      { return toNull(string(s)) }
+func StringFromNull(n NullString) String { return String(fromNull(n)) }
+
+func (t Time) ToNull() NullTime {
+	return toNull(time.Time(t))
+}
+
+func TimeFromNull(n NullTime) Time {
+	return Time(fromNull(n))
+}
@@ -0,0 +1,152 @@
+package nnz
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestNullIntScanDriverInt64 exercises the exact shape a real integer
+// column Scan produces (int64), which NullInt.Scan originally rejected via
+// a bare v.(int) assertion.
+func TestNullIntScanDriverInt64(t *testing.T) {
+	var n NullInt
+	if err := n.Scan(int64(42)); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.Val != 42 {
+		t.Errorf("Scan(int64(42)) = %+v, want {42 true}", n)
+	}
+}
+
+// TestNullIntValueIsDriverValue checks Value() returns a type
+// database/sql/driver.IsValue accepts, not a bare Go int.
+func TestNullIntValueIsDriverValue(t *testing.T) {
+	n := NullInt{Val: 42, Valid: true}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !driver.IsValue(v) {
+		t.Errorf("Value() = %v (%T) is not a valid driver.Value", v, v)
+	}
+	if v != int64(42) {
+		t.Errorf("Value() = %v, want int64(42)", v)
+	}
+}
+
+// TestNullStringScanDriverBytes exercises the []byte shape a TEXT column
+// Scan commonly produces, which NullString.Scan originally rejected.
+func TestNullStringScanDriverBytes(t *testing.T) {
+	var n NullString
+	if err := n.Scan([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.Val != "hello" {
+		t.Errorf("Scan([]byte) = %+v, want {hello true}", n)
+	}
+}
+
+func TestNullIntScanNull(t *testing.T) {
+	n := NullInt{Val: 7, Valid: true}
+	if err := n.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if n.Valid || n.Val != 0 {
+		t.Errorf("Scan(nil) = %+v, want {0 false}", n)
+	}
+}
+
+func TestNullIntValueNull(t *testing.T) {
+	var n NullInt
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value() of unset NullInt = %v, want nil", v)
+	}
+}
+
+// TestNullIntPreservesRealZero is the behavior NullInt exists for: unlike
+// Int, a real 0 round-trips as valid, non-null data.
+func TestNullIntPreservesRealZero(t *testing.T) {
+	n := NullInt{Val: 0, Valid: true}
+
+	data, err := json.Marshal(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "0" {
+		t.Errorf("MarshalJSON({0 true}) = %s, want 0", data)
+	}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != int64(0) {
+		t.Errorf("Value({0 true}) = %v, want int64(0)", v)
+	}
+}
+
+func TestNullStringJSONRoundTrip(t *testing.T) {
+	want := NullString{Val: "hi", Valid: true}
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got NullString
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("round trip = %+v, want %+v", got, want)
+	}
+
+	var unset NullString
+	if err := json.Unmarshal([]byte("null"), &unset); err != nil {
+		t.Fatal(err)
+	}
+	if unset.Valid {
+		t.Errorf("unmarshaling null set Valid = true")
+	}
+}
+
+func TestBoolToNullFromNull(t *testing.T) {
+	if got := Bool(true).ToNull(); got != (NullBool{Val: true, Valid: true}) {
+		t.Errorf("true.ToNull() = %+v", got)
+	}
+	if got := Bool(false).ToNull(); got != (NullBool{Val: false, Valid: false}) {
+		t.Errorf("false.ToNull() = %+v", got)
+	}
+	if got := BoolFromNull(NullBool{Val: true, Valid: true}); got != true {
+		t.Errorf("BoolFromNull({true true}) = %v", got)
+	}
+	if got := BoolFromNull(NullBool{}); got != false {
+		t.Errorf("BoolFromNull({}) = %v", got)
+	}
+}
+
+func TestTimeToNullFromNull(t *testing.T) {
+	tm := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	n := Time(tm).ToNull()
+	if !n.Valid || !n.Val.Equal(tm) {
+		t.Errorf("Time(tm).ToNull() = %+v, want {%v true}", n, tm)
+	}
+
+	if got := TimeFromNull(NullTime{}); !time.Time(got).IsZero() {
+		t.Errorf("TimeFromNull({}) = %v, want zero time", got)
+	}
+}
+
+var (
+	_ sql.Scanner   = (*NullInt)(nil)
+	_ driver.Valuer = NullInt{}
+)
@@ -0,0 +1,294 @@
+package nnz
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Nullable is a generic wrapper around a comparable value T where T's zero
+// value represents SQL/JSON null, generalizing the pattern used by Bool,
+// Int, Int64, Float64, String, and Time. It lets callers write
+// nnz.Nullable[uint32], nnz.Nullable[MyEnum], and so on without a
+// hand-written type for every primitive. Bool, Int, Int64, Float64, and
+// String remain distinct defined types for backward compatibility, but
+// their Scan, Value, and MarshalJSON, and Bool's and String's
+// UnmarshalJSON, delegate to the same conversions used here. Int, Int64,
+// and Float64 decode JSON through decodeJSONInt/decodeJSONFloat instead,
+// which Nullable[T] does not use.
+type Nullable[T comparable] struct {
+	Val T
+}
+
+// Scan implements the database/sql/driver.Scanner interface.
+func (n *Nullable[T]) Scan(v interface{}) error {
+	return scanValue(&n.Val, v)
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	return valueOf(n.Val)
+}
+
+// MarshalJSON implements the encoding/json.Marshaler interface.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONValue(n.Val)
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONValue(&n.Val, data)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (n Nullable[T]) MarshalText() ([]byte, error) {
+	var zero T
+	if n.Val == zero {
+		return nil, nil
+	}
+	if tm, ok := any(n.Val).(encoding.TextMarshaler); ok {
+		return tm.MarshalText()
+	}
+	return []byte(fmt.Sprint(n.Val)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (n *Nullable[T]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		var zero T
+		n.Val = zero
+		return nil
+	}
+	if tu, ok := any(&n.Val).(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText(data)
+	}
+	_, err := fmt.Sscan(string(data), &n.Val)
+	return err
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (n Nullable[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(n.Val); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (n *Nullable[T]) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		var zero T
+		n.Val = zero
+		return nil
+	}
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(&n.Val)
+}
+
+// scanValue implements database/sql/driver.Scanner-style conversion for any
+// comparable T, converting an int64 to any integer kind, a float64 to any
+// float kind, and []byte/string to string. It backs both Nullable[T] and the
+// legacy per-primitive types' Scan methods.
+func scanValue[T comparable](dst *T, src interface{}) error {
+	if src == nil {
+		var zero T
+		*dst = zero
+		return nil
+	}
+
+	if t, ok := any(dst).(*time.Time); ok {
+		switch v := src.(type) {
+		case time.Time:
+			*t = v
+			return nil
+		default:
+			return &TypeError{Target: fmt.Sprintf("%T", dst), Got: fmt.Sprintf("%T", src), Source: "sql"}
+		}
+	}
+
+	rv := reflect.ValueOf(dst).Elem()
+
+	switch v := src.(type) {
+	case int64:
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			rv.SetInt(v)
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			rv.SetUint(uint64(v))
+			return nil
+		case reflect.Bool:
+			if !Strict && (v == 0 || v == 1) {
+				rv.SetBool(v != 0)
+				return nil
+			}
+		case reflect.String:
+			if !Strict {
+				rv.SetString(fmt.Sprint(v))
+				return nil
+			}
+		}
+	case float64:
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			rv.SetFloat(v)
+			return nil
+		case reflect.String:
+			if !Strict {
+				rv.SetString(fmt.Sprint(v))
+				return nil
+			}
+		}
+	case bool:
+		switch rv.Kind() {
+		case reflect.Bool:
+			rv.SetBool(v)
+			return nil
+		case reflect.String:
+			if !Strict {
+				rv.SetString(strconv.FormatBool(v))
+				return nil
+			}
+		}
+	case []byte:
+		if rv.Kind() == reflect.String {
+			rv.SetString(string(v))
+			return nil
+		}
+	case string:
+		switch rv.Kind() {
+		case reflect.String:
+			rv.SetString(v)
+			return nil
+		case reflect.Bool:
+			if !Strict {
+				switch v {
+				case "true":
+					rv.SetBool(true)
+					return nil
+				case "false", "":
+					rv.SetBool(false)
+					return nil
+				}
+			}
+		}
+	}
+
+	return &TypeError{Target: fmt.Sprintf("%T", dst), Got: fmt.Sprintf("%T", src), Source: "sql"}
+}
+
+// valueOf implements database/sql/driver.Valuer-style conversion for any
+// comparable T, returning nil for the zero value and the underlying
+// int64/float64/bool/string/time.Time otherwise.
+func valueOf[T comparable](v T) (driver.Value, error) {
+	var zero T
+	if v == zero {
+		return nil, nil
+	}
+	return driverValue(v), nil
+}
+
+// driverValue converts v to one of the types driver.Value accepts
+// (int64/float64/bool/string/time.Time), with no zero-is-null check, so
+// callers that track nullness separately (Null[T]) don't lose a real zero
+// value. It backs valueOf and Null[T].Value.
+func driverValue[T any](v T) driver.Value {
+	if t, ok := any(v).(time.Time); ok {
+		return t
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.String:
+		return rv.String()
+	default:
+		return v
+	}
+}
+
+// marshalJSONValue implements encoding/json.Marshaler-style conversion for
+// any comparable T, emitting null for the zero value.
+func marshalJSONValue[T comparable](v T) ([]byte, error) {
+	var zero T
+	if v == zero {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(v)
+}
+
+// unmarshalJSONValue implements encoding/json.Unmarshaler-style conversion
+// for any comparable T, setting the zero value on JSON null. Bool and
+// string targets additionally accept the other's JSON shape (0/1 or
+// "true"/"false" for bool, a number or bool for string) unless Strict is
+// set, matching the coercions scanValue applies for database/sql.
+func unmarshalJSONValue[T comparable](dst *T, data []byte) error {
+	if string(data) == "null" {
+		var zero T
+		*dst = zero
+		return nil
+	}
+
+	rv := reflect.ValueOf(dst).Elem()
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		switch v := v.(type) {
+		case bool:
+			rv.SetBool(v)
+			return nil
+		case float64:
+			if !Strict && (v == 0 || v == 1) {
+				rv.SetBool(v != 0)
+				return nil
+			}
+		case string:
+			if !Strict {
+				switch v {
+				case "true":
+					rv.SetBool(true)
+					return nil
+				case "false", "":
+					rv.SetBool(false)
+					return nil
+				}
+			}
+		}
+		return &TypeError{Target: fmt.Sprintf("%T", dst), Got: fmt.Sprintf("%T", v), Source: "json"}
+	case reflect.String:
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		switch v := v.(type) {
+		case string:
+			rv.SetString(v)
+			return nil
+		case float64, bool:
+			if !Strict {
+				rv.SetString(fmt.Sprint(v))
+				return nil
+			}
+		}
+		return &TypeError{Target: fmt.Sprintf("%T", dst), Got: fmt.Sprintf("%T", v), Source: "json"}
+	default:
+		return json.Unmarshal(data, dst)
+	}
+}
@@ -0,0 +1,71 @@
+package nnz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// decodeJSONNumber decodes a JSON number, or a JSON string containing one,
+// using json.Number so that integers beyond 2^53 (Snowflake IDs, database
+// primary keys, Unix-nanos, and the like) survive the round trip instead of
+// silently losing precision through an intermediate float64.
+func decodeJSONNumber(data []byte) (json.Number, bool, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return "", false, err
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return "", false, nil
+	case json.Number:
+		return v, true, nil
+	case string:
+		return json.Number(v), true, nil
+	default:
+		return "", false, fmt.Errorf("got %T", v)
+	}
+}
+
+// decodeJSONInt decodes data as an integer via decodeJSONNumber, returning
+// an error instead of truncating when the number has a fractional part.
+// This rejection is unconditional and not governed by Strict: truncating
+// 1.5 into 1 is a correctness bug regardless of mode.
+func decodeJSONInt(data []byte) (int64, bool, error) {
+	num, ok, err := decodeJSONNumber(data)
+	if err != nil || !ok {
+		return 0, ok, err
+	}
+
+	if n, err := num.Int64(); err == nil {
+		return n, true, nil
+	}
+
+	f, err := num.Float64()
+	if err != nil {
+		return 0, false, fmt.Errorf("not an integer: %q", num)
+	}
+	if f != math.Trunc(f) {
+		return 0, false, fmt.Errorf("not an integer: %s", num)
+	}
+	return int64(f), true, nil
+}
+
+// decodeJSONFloat decodes data as a float via decodeJSONNumber.
+func decodeJSONFloat(data []byte) (float64, bool, error) {
+	num, ok, err := decodeJSONNumber(data)
+	if err != nil || !ok {
+		return 0, ok, err
+	}
+
+	f, err := num.Float64()
+	if err != nil {
+		return 0, false, err
+	}
+	return f, true, nil
+}
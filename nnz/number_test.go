@@ -0,0 +1,123 @@
+package nnz
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestInt64UnmarshalJSONMaxInt64(t *testing.T) {
+	var i Int64
+	if err := i.UnmarshalJSON([]byte("9223372036854775807")); err != nil {
+		t.Fatal(err)
+	}
+	if i != Int64(math.MaxInt64) {
+		t.Errorf("got %d, want %d", i, int64(math.MaxInt64))
+	}
+}
+
+func TestInt64UnmarshalJSONNegativeMaxInt64(t *testing.T) {
+	var i Int64
+	if err := i.UnmarshalJSON([]byte("-9223372036854775807")); err != nil {
+		t.Fatal(err)
+	}
+	if i != Int64(-math.MaxInt64) {
+		t.Errorf("got %d, want %d", i, int64(-math.MaxInt64))
+	}
+}
+
+// TestInt64UnmarshalJSONPreservesPrecision is the regression this request
+// exists for: decoding through float64 would round this Snowflake-style ID
+// to a nearby representable float and silently corrupt it.
+func TestInt64UnmarshalJSONPreservesPrecision(t *testing.T) {
+	const want = int64(9007199254740993) // 2^53 + 1, not representable exactly as float64
+
+	var i Int64
+	if err := i.UnmarshalJSON([]byte("9007199254740993")); err != nil {
+		t.Fatal(err)
+	}
+	if int64(i) != want {
+		t.Errorf("got %d, want %d", i, want)
+	}
+}
+
+func TestInt64UnmarshalJSONStringInput(t *testing.T) {
+	var i Int64
+	if err := i.UnmarshalJSON([]byte(`"9007199254740993"`)); err != nil {
+		t.Fatal(err)
+	}
+	if i != 9007199254740993 {
+		t.Errorf("got %d, want 9007199254740993", i)
+	}
+}
+
+func TestIntUnmarshalJSONStringInput(t *testing.T) {
+	var i Int
+	if err := i.UnmarshalJSON([]byte(`"42"`)); err != nil {
+		t.Fatal(err)
+	}
+	if i != 42 {
+		t.Errorf("got %d, want 42", i)
+	}
+}
+
+func TestIntUnmarshalJSONRejectsFraction(t *testing.T) {
+	var i Int
+	if err := i.UnmarshalJSON([]byte("1.5")); err == nil {
+		t.Fatal("expected error unmarshaling 1.5 into Int")
+	}
+}
+
+func TestInt64UnmarshalJSONRejectsFractionalString(t *testing.T) {
+	var i Int64
+	if err := i.UnmarshalJSON([]byte(`"1.5"`)); err == nil {
+		t.Fatal("expected error unmarshaling \"1.5\" into Int64")
+	}
+}
+
+func TestIntUnmarshalJSONNull(t *testing.T) {
+	var i Int
+	if err := i.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+	if i != 0 {
+		t.Errorf("got %d, want 0", i)
+	}
+}
+
+func TestFloat64UnmarshalJSONStringInput(t *testing.T) {
+	var f Float64
+	if err := f.UnmarshalJSON([]byte(`"3.5"`)); err != nil {
+		t.Fatal(err)
+	}
+	if f != 3.5 {
+		t.Errorf("got %v, want 3.5", f)
+	}
+}
+
+func TestFloat64UnmarshalJSONNumberInput(t *testing.T) {
+	var f Float64
+	if err := f.UnmarshalJSON([]byte("3.5")); err != nil {
+		t.Fatal(err)
+	}
+	if f != 3.5 {
+		t.Errorf("got %v, want 3.5", f)
+	}
+}
+
+func TestInt64JSONMarshalUnmarshalRoundTrip(t *testing.T) {
+	want := Int64(math.MaxInt64)
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Int64
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("round trip = %d, want %d", got, want)
+	}
+}
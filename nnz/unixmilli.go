@@ -0,0 +1,83 @@
+package nnz
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// UnixMilli is a wrapper around time.Time where the zero time serializes to
+// SQL/JSON null, like Time, but its JSON and SQL representation is an
+// integer number of milliseconds since the Unix epoch rather than RFC3339.
+// It suits event-stream and time-series consumers (Prometheus, Kafka, Redis
+// streams) that use ms-epoch timestamps and can't use Time.
+type UnixMilli time.Time
+
+// Scan implements the database/sql/driver.Scanner interface.
+func (t *UnixMilli) Scan(v interface{}) error {
+	if v == nil {
+		*t = UnixMilli(zt)
+		return nil
+	}
+
+	var ms int64
+	switch v := v.(type) {
+	case int64:
+		ms = v
+	case float64:
+		ms = int64(v)
+	case []byte:
+		n, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("nnz: scanning %T: %w", t, err)
+		}
+		ms = n
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("nnz: scanning %T: %w", t, err)
+		}
+		ms = n
+	default:
+		return &TypeError{Target: fmt.Sprintf("%T", t), Got: fmt.Sprintf("%T", v), Source: "sql"}
+	}
+
+	*t = UnixMilli(time.UnixMilli(ms))
+	return nil
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+func (t UnixMilli) Value() (driver.Value, error) {
+	tm := time.Time(t)
+	if tm.IsZero() {
+		return nil, nil
+	}
+	return tm.UnixMilli(), nil
+}
+
+// MarshalJSON implements the encoding/json.Marshaler interface.
+func (t UnixMilli) MarshalJSON() ([]byte, error) {
+	tm := time.Time(t)
+	if tm.IsZero() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, tm.UnixMilli(), 10), nil
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface. It
+// accepts a JSON number of milliseconds since the epoch, or null.
+func (t *UnixMilli) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = UnixMilli(zt)
+		return nil
+	}
+
+	ms, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("nnz: unmarshaling %T: %w", t, err)
+	}
+
+	*t = UnixMilli(time.UnixMilli(ms))
+	return nil
+}
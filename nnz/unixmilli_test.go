@@ -0,0 +1,118 @@
+package nnz
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUnixMilliZeroIsNull(t *testing.T) {
+	var u UnixMilli
+
+	data, err := u.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON(zero) = %s, want null", data)
+	}
+
+	v, err := u.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value(zero) = %v, want nil", v)
+	}
+}
+
+func TestUnixMilliScanInt64(t *testing.T) {
+	var u UnixMilli
+	if err := u.Scan(int64(1700000000123)); err != nil {
+		t.Fatal(err)
+	}
+	if got := time.Time(u).UnixMilli(); got != 1700000000123 {
+		t.Errorf("Scan(int64) = %d ms, want 1700000000123", got)
+	}
+}
+
+func TestUnixMilliScanFloat64(t *testing.T) {
+	var u UnixMilli
+	if err := u.Scan(float64(1700000000123)); err != nil {
+		t.Fatal(err)
+	}
+	if got := time.Time(u).UnixMilli(); got != 1700000000123 {
+		t.Errorf("Scan(float64) = %d ms, want 1700000000123", got)
+	}
+}
+
+func TestUnixMilliScanBytesAndString(t *testing.T) {
+	var u UnixMilli
+	if err := u.Scan([]byte("1700000000123")); err != nil {
+		t.Fatal(err)
+	}
+	if got := time.Time(u).UnixMilli(); got != 1700000000123 {
+		t.Errorf("Scan([]byte) = %d ms, want 1700000000123", got)
+	}
+
+	var u2 UnixMilli
+	if err := u2.Scan("1700000000123"); err != nil {
+		t.Fatal(err)
+	}
+	if got := time.Time(u2).UnixMilli(); got != 1700000000123 {
+		t.Errorf("Scan(string) = %d ms, want 1700000000123", got)
+	}
+}
+
+func TestUnixMilliScanNil(t *testing.T) {
+	u := UnixMilli(time.Now())
+	if err := u.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if !time.Time(u).IsZero() {
+		t.Errorf("Scan(nil) = %v, want zero time", time.Time(u))
+	}
+}
+
+func TestUnixMilliScanRejectsWrongShape(t *testing.T) {
+	var u UnixMilli
+	err := u.Scan(true)
+	if err == nil {
+		t.Fatal("expected error scanning bool into UnixMilli")
+	}
+	var te *TypeError
+	if !errors.As(err, &te) {
+		t.Fatalf("expected *TypeError, got %T: %v", err, err)
+	}
+}
+
+func TestUnixMilliJSONRoundTrip(t *testing.T) {
+	want := UnixMilli(time.UnixMilli(1700000000123))
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "1700000000123" {
+		t.Errorf("MarshalJSON = %s, want 1700000000123", data)
+	}
+
+	var got UnixMilli
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !time.Time(got).Equal(time.Time(want)) {
+		t.Errorf("round trip = %v, want %v", time.Time(got), time.Time(want))
+	}
+}
+
+func TestUnixMilliUnmarshalJSONNull(t *testing.T) {
+	u := UnixMilli(time.Now())
+	if err := u.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+	if !time.Time(u).IsZero() {
+		t.Errorf("UnmarshalJSON(null) = %v, want zero time", time.Time(u))
+	}
+}
@@ -0,0 +1,87 @@
+package nnz
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// UUID is a wrapper around uuid.UUID where the zero (nil) UUID serializes to
+// SQL/JSON null. JSON uses the canonical 8-4-4-4-12 hex form; Scan
+// accepts both the driver's binary 16-byte form and the string form, and
+// Value returns the string form. This covers a common Postgres/MySQL
+// column type the rest of this package cannot represent.
+type UUID uuid.UUID
+
+var zu uuid.UUID // the zero (nil) UUID.
+
+// Scan implements the database/sql/driver.Scanner interface.
+func (u *UUID) Scan(v interface{}) error {
+	if v == nil {
+		*u = UUID(zu)
+		return nil
+	}
+	switch v := v.(type) {
+	case []byte:
+		if len(v) == 16 {
+			id, err := uuid.FromBytes(v)
+			if err != nil {
+				return fmt.Errorf("nnz: scanning %T: %w", u, err)
+			}
+			*u = UUID(id)
+			return nil
+		}
+		id, err := uuid.ParseBytes(v)
+		if err != nil {
+			return fmt.Errorf("nnz: scanning %T: %w", u, err)
+		}
+		*u = UUID(id)
+	case string:
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return fmt.Errorf("nnz: scanning %T: %w", u, err)
+		}
+		*u = UUID(id)
+	default:
+		return &TypeError{Target: fmt.Sprintf("%T", u), Got: fmt.Sprintf("%T", v), Source: "sql"}
+	}
+	return nil
+}
+
+// Value implements the database/sql/driver.Valuer interface.
+func (u UUID) Value() (driver.Value, error) {
+	id := uuid.UUID(u)
+	if id == zu {
+		return nil, nil
+	}
+	return id.String(), nil
+}
+
+// MarshalJSON implements the encoding/json.Marshaler interface.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	id := uuid.UUID(u)
+	if id == zu {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(id.String())
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*u = UUID(zu)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("nnz: unmarshaling %T: %w", u, err)
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return fmt.Errorf("nnz: unmarshaling %T: %w", u, err)
+	}
+	*u = UUID(id)
+	return nil
+}
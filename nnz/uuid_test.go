@@ -0,0 +1,100 @@
+package nnz
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestUUIDZeroIsNull(t *testing.T) {
+	var u UUID
+
+	data, err := u.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON(zero) = %s, want null", data)
+	}
+
+	v, err := u.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value(zero) = %v, want nil", v)
+	}
+}
+
+func TestUUIDScanBinaryBytes(t *testing.T) {
+	want := uuid.New()
+
+	var u UUID
+	if err := u.Scan(want[:]); err != nil {
+		t.Fatal(err)
+	}
+	if uuid.UUID(u) != want {
+		t.Errorf("Scan(16-byte []byte) = %v, want %v", uuid.UUID(u), want)
+	}
+}
+
+func TestUUIDScanTextBytesAndString(t *testing.T) {
+	want := uuid.New()
+
+	var u UUID
+	if err := u.Scan([]byte(want.String())); err != nil {
+		t.Fatal(err)
+	}
+	if uuid.UUID(u) != want {
+		t.Errorf("Scan(text []byte) = %v, want %v", uuid.UUID(u), want)
+	}
+
+	var u2 UUID
+	if err := u2.Scan(want.String()); err != nil {
+		t.Fatal(err)
+	}
+	if uuid.UUID(u2) != want {
+		t.Errorf("Scan(string) = %v, want %v", uuid.UUID(u2), want)
+	}
+}
+
+func TestUUIDScanNil(t *testing.T) {
+	u := UUID(uuid.New())
+	if err := u.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if uuid.UUID(u) != (uuid.UUID{}) {
+		t.Errorf("Scan(nil) = %v, want zero UUID", uuid.UUID(u))
+	}
+}
+
+func TestUUIDScanRejectsWrongShape(t *testing.T) {
+	var u UUID
+	err := u.Scan(42)
+	if err == nil {
+		t.Fatal("expected error scanning int into UUID")
+	}
+	var te *TypeError
+	if !errors.As(err, &te) {
+		t.Fatalf("expected *TypeError, got %T: %v", err, err)
+	}
+}
+
+func TestUUIDJSONRoundTrip(t *testing.T) {
+	want := UUID(uuid.New())
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got UUID
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("round trip = %v, want %v", uuid.UUID(got), uuid.UUID(want))
+	}
+}